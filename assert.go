@@ -9,9 +9,13 @@
 package assert
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
+	"time"
 )
 
 // TB contains the parts of testing.TB that this package actually needs. Pass *testing.T or *testing.B for arguments of type TB.
@@ -41,10 +45,20 @@ func False(t TB, a bool, messageAndArgs ...any) bool {
 }
 
 // Eq asserts that two values are equal via == operator.
+//
+// For string values, a large or multi-line mismatch is rendered as a unified
+// diff instead of a single "got/wanted" line. Values are rendered with %v;
+// the formatter set by SetFormatter is not used here.
 func Eq[T comparable](t TB, a, e T, messageAndArgs ...any) bool {
 	if a != e {
 		t.Helper()
-		t.Errorf("** %sgot %v, wanted %v", FormatPrefix(messageAndArgs), a, e)
+		msg := fmt.Sprintf("got %v, wanted %v", a, e)
+		if as, ok := any(a).(string); ok {
+			if es := any(e).(string); needsDiff(as, es) {
+				msg = "\n" + unifiedDiff(as, es)
+			}
+		}
+		t.Errorf("** %s%s", FormatPrefix(messageAndArgs), msg)
 		return false
 	}
 	return true
@@ -61,10 +75,13 @@ func NotEq[T comparable](t TB, a, e T, messageAndArgs ...any) bool {
 }
 
 // DeepEqual asserts that two values are equal via reflect.DeepEqual.
+//
+// A large or multi-line mismatch is rendered as a unified diff instead of a
+// single "got/wanted" line; see SetFormatter.
 func DeepEqual[T any](t TB, a, e T, messageAndArgs ...any) bool {
 	if !reflect.DeepEqual(a, e) {
 		t.Helper()
-		t.Errorf("** %sgot %v, wanted %v", FormatPrefix(messageAndArgs), a, e)
+		t.Errorf("** %s%s", FormatPrefix(messageAndArgs), diffOrCompact(formatter(a), formatter(e)))
 		return false
 	}
 	return true
@@ -102,6 +119,370 @@ func NotMethodEqual[T interface{ Equal(T) bool }](t TB, a, e T, messageAndArgs .
 	return true
 }
 
+// Greater asserts that a > e.
+func Greater[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	if !(a > e) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted > %v", FormatPrefix(messageAndArgs), a, e)
+		return false
+	}
+	return true
+}
+
+// Less asserts that a < e.
+func Less[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	if !(a < e) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted < %v", FormatPrefix(messageAndArgs), a, e)
+		return false
+	}
+	return true
+}
+
+// GreaterOrEq asserts that a >= e.
+func GreaterOrEq[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	if !(a >= e) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted >= %v", FormatPrefix(messageAndArgs), a, e)
+		return false
+	}
+	return true
+}
+
+// LessOrEq asserts that a <= e.
+func LessOrEq[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	if !(a <= e) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted <= %v", FormatPrefix(messageAndArgs), a, e)
+		return false
+	}
+	return true
+}
+
+// Between asserts that lo <= a <= hi.
+func Between[T cmp.Ordered](t TB, a, lo, hi T, messageAndArgs ...any) bool {
+	if !(lo <= a && a <= hi) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted in [%v, %v]", FormatPrefix(messageAndArgs), a, lo, hi)
+		return false
+	}
+	return true
+}
+
+// Cmp asserts that two values are equal according to the given comparison
+// function, which should return 0 for equal values (like (*big.Int).Cmp or
+// time.Time.Compare). Use this for ordered types that don't satisfy
+// cmp.Ordered, such as *big.Int or time.Time.
+func Cmp[T any](t TB, a, e T, cmp func(a, e T) int, messageAndArgs ...any) bool {
+	if cmp(a, e) != 0 {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted %v", FormatPrefix(messageAndArgs), a, e)
+		return false
+	}
+	return true
+}
+
+// InDelta asserts that a and e differ by no more than delta, i.e. that
+// math.Abs(a-e) <= delta. NaN is never within any delta. Equal infinities
+// (of the same sign) are always considered within delta.
+func InDelta(t TB, a, e, delta float64, messageAndArgs ...any) bool {
+	if math.IsNaN(a) || math.IsNaN(e) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted %v ± %v (NaN is never within any delta)", FormatPrefix(messageAndArgs), a, e, delta)
+		return false
+	}
+	if a == e {
+		return true
+	}
+	if d := math.Abs(a - e); d > delta {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted %v ± %v (actual delta: %v)", FormatPrefix(messageAndArgs), a, e, delta, d)
+		return false
+	}
+	return true
+}
+
+// InEpsilon asserts that a and e differ by no more than epsilon as a
+// fraction of e, i.e. that math.Abs((a-e)/e) <= epsilon. NaN is never
+// within any epsilon, equal infinities (of the same sign) are always
+// within epsilon, and e == 0 is only within epsilon of itself (use InDelta
+// instead when the expected value can be zero).
+func InEpsilon(t TB, a, e, epsilon float64, messageAndArgs ...any) bool {
+	if math.IsNaN(a) || math.IsNaN(e) {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted %v ± %v (NaN is never within any epsilon)", FormatPrefix(messageAndArgs), a, e, epsilon)
+		return false
+	}
+	if a == e {
+		return true
+	}
+	if e == 0 {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted %v (relative error is undefined when wanted value is 0; use InDelta instead)", FormatPrefix(messageAndArgs), a, e)
+		return false
+	}
+	if re := math.Abs((a - e) / e); math.IsNaN(re) || re > epsilon {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted %v ± %v (actual epsilon: %v)", FormatPrefix(messageAndArgs), a, e, epsilon, re)
+		return false
+	}
+	return true
+}
+
+// InDeltaSlice asserts that a and e have the same length and that each pair
+// of corresponding elements is within delta of each other (see InDelta),
+// reporting the first differing index.
+func InDeltaSlice(t TB, a, e []float64, delta float64, messageAndArgs ...any) bool {
+	if len(a) != len(e) {
+		t.Helper()
+		t.Errorf("** %sgot slice of length %d, wanted length %d", FormatPrefix(messageAndArgs), len(a), len(e))
+		return false
+	}
+	for i := range a {
+		if !inDelta(a[i], e[i], delta) {
+			t.Helper()
+			t.Errorf("** %sgot %v at index %d, wanted %v ± %v", FormatPrefix(messageAndArgs), a[i], i, e[i], delta)
+			return false
+		}
+	}
+	return true
+}
+
+// InEpsilonSlice asserts that a and e have the same length and that each
+// pair of corresponding elements is within epsilon of each other (see
+// InEpsilon), reporting the first differing index.
+func InEpsilonSlice(t TB, a, e []float64, epsilon float64, messageAndArgs ...any) bool {
+	if len(a) != len(e) {
+		t.Helper()
+		t.Errorf("** %sgot slice of length %d, wanted length %d", FormatPrefix(messageAndArgs), len(a), len(e))
+		return false
+	}
+	for i := range a {
+		if !inEpsilon(a[i], e[i], epsilon) {
+			t.Helper()
+			t.Errorf("** %sgot %v at index %d, wanted %v ± %v", FormatPrefix(messageAndArgs), a[i], i, e[i], epsilon)
+			return false
+		}
+	}
+	return true
+}
+
+func inDelta(a, e, delta float64) bool {
+	if math.IsNaN(a) || math.IsNaN(e) {
+		return false
+	}
+	if a == e {
+		return true
+	}
+	return math.Abs(a-e) <= delta
+}
+
+func inEpsilon(a, e, epsilon float64) bool {
+	if math.IsNaN(a) || math.IsNaN(e) {
+		return false
+	}
+	if a == e {
+		return true
+	}
+	if e == 0 {
+		return false
+	}
+	return math.Abs((a-e)/e) <= epsilon
+}
+
+// ContainsSubstring asserts that haystack contains needle as a substring.
+func ContainsSubstring(t TB, haystack, needle string, messageAndArgs ...any) bool {
+	if !strings.Contains(haystack, needle) {
+		t.Helper()
+		t.Errorf("** %sgot %q, wanted to contain %q", FormatPrefix(messageAndArgs), haystack, needle)
+		return false
+	}
+	return true
+}
+
+// NotContainsSubstring asserts that haystack does not contain needle as a substring.
+func NotContainsSubstring(t TB, haystack, needle string, messageAndArgs ...any) bool {
+	if strings.Contains(haystack, needle) {
+		t.Helper()
+		t.Errorf("** %sgot %q, wanted to not contain %q", FormatPrefix(messageAndArgs), haystack, needle)
+		return false
+	}
+	return true
+}
+
+// ContainsElement asserts that haystack contains needle as one of its elements.
+func ContainsElement[T comparable, S ~[]T](t TB, haystack S, needle T, messageAndArgs ...any) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	t.Helper()
+	t.Errorf("** %sgot %s, wanted to contain %v", FormatPrefix(messageAndArgs), formatSlice(haystack), needle)
+	return false
+}
+
+// NotContainsElement asserts that haystack does not contain needle among its elements.
+func NotContainsElement[T comparable, S ~[]T](t TB, haystack S, needle T, messageAndArgs ...any) bool {
+	for _, v := range haystack {
+		if v == needle {
+			t.Helper()
+			t.Errorf("** %sgot %s, wanted to not contain %v", FormatPrefix(messageAndArgs), formatSlice(haystack), needle)
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsElementFunc asserts that haystack contains an element satisfying pred.
+func ContainsElementFunc[T any, S ~[]T](t TB, haystack S, pred func(T) bool, messageAndArgs ...any) bool {
+	for _, v := range haystack {
+		if pred(v) {
+			return true
+		}
+	}
+	t.Helper()
+	t.Errorf("** %sgot %s, wanted an element matching the predicate", FormatPrefix(messageAndArgs), formatSlice(haystack))
+	return false
+}
+
+// NotContainsElementFunc asserts that no element of haystack satisfies pred.
+func NotContainsElementFunc[T any, S ~[]T](t TB, haystack S, pred func(T) bool, messageAndArgs ...any) bool {
+	for _, v := range haystack {
+		if pred(v) {
+			t.Helper()
+			t.Errorf("** %sgot %s, wanted no element matching the predicate", FormatPrefix(messageAndArgs), formatSlice(haystack))
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsKey asserts that the given map has an entry for key.
+func ContainsKey[K comparable, V any, M ~map[K]V](t TB, m M, key K, messageAndArgs ...any) bool {
+	if _, ok := m[key]; !ok {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted to contain key %v", FormatPrefix(messageAndArgs), m, key)
+		return false
+	}
+	return true
+}
+
+// NotContainsKey asserts that the given map has no entry for key.
+func NotContainsKey[K comparable, V any, M ~map[K]V](t TB, m M, key K, messageAndArgs ...any) bool {
+	if _, ok := m[key]; ok {
+		t.Helper()
+		t.Errorf("** %sgot %v, wanted to not contain key %v", FormatPrefix(messageAndArgs), m, key)
+		return false
+	}
+	return true
+}
+
+// formatSlice formats a slice for error messages, truncating to the first
+// and last 3 elements when it has more than 10 elements.
+func formatSlice[T any, S ~[]T](s S) string {
+	if len(s) <= 10 {
+		return fmt.Sprint(s)
+	}
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, v := range s[:3] {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v", v)
+	}
+	b.WriteString(" ... ")
+	for i, v := range s[len(s)-3:] {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v", v)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// Eventually polls cond every tick until it returns true or timeout elapses.
+// cond runs on a goroutine, and a panic in cond is reported as an assertion
+// failure rather than crashing the test.
+func Eventually(t TB, cond func() bool, timeout, tick time.Duration, messageAndArgs ...any) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, panicValue := runCond(cond)
+		if panicValue != nil {
+			t.Helper()
+			t.Errorf("** %scondition panicked: %v", FormatPrefix(messageAndArgs), panicValue)
+			return false
+		}
+		if ok {
+			return true
+		}
+		if time.Now().After(deadline) {
+			t.Helper()
+			t.Errorf("** %stimed out after %v waiting for condition to become true", FormatPrefix(messageAndArgs), timeout)
+			return false
+		}
+		time.Sleep(tick)
+	}
+}
+
+// Never polls cond every tick for duration and fails if it ever returns
+// true. cond runs on a goroutine, and a panic in cond is reported as an
+// assertion failure rather than crashing the test.
+func Never(t TB, cond func() bool, duration, tick time.Duration, messageAndArgs ...any) bool {
+	deadline := time.Now().Add(duration)
+	for time.Now().Before(deadline) {
+		ok, panicValue := runCond(cond)
+		if panicValue != nil {
+			t.Helper()
+			t.Errorf("** %scondition panicked: %v", FormatPrefix(messageAndArgs), panicValue)
+			return false
+		}
+		if ok {
+			t.Helper()
+			t.Errorf("** %sgot condition become true, wanted it to stay false for %v", FormatPrefix(messageAndArgs), duration)
+			return false
+		}
+		time.Sleep(tick)
+	}
+	return true
+}
+
+// EventuallyEq polls get every tick until it returns expected or timeout
+// elapses, reporting the last observed value on timeout.
+func EventuallyEq[T comparable](t TB, get func() T, expected T, timeout, tick time.Duration, messageAndArgs ...any) bool {
+	deadline := time.Now().Add(timeout)
+	var last T
+	for {
+		last = get()
+		if last == expected {
+			return true
+		}
+		if time.Now().After(deadline) {
+			t.Helper()
+			t.Errorf("** %stimed out after %v; last got %v, wanted %v", FormatPrefix(messageAndArgs), timeout, last, expected)
+			return false
+		}
+		time.Sleep(tick)
+	}
+}
+
+// runCond runs cond on a goroutine and recovers from any panic, returning
+// the panic value (if any) instead of letting it crash the test.
+func runCond(cond func() bool) (result bool, panicValue any) {
+	done := make(chan struct{})
+	go func() {
+		defer func() {
+			panicValue = recover()
+			close(done)
+		}()
+		result = cond()
+	}()
+	<-done
+	return
+}
+
 // Nil asserts that a pointer value is nil.
 //
 // Use Zero for interface values. Nil is
@@ -3,6 +3,8 @@ package assert_test
 import (
 	"fmt"
 	"io/fs"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -49,6 +51,476 @@ func TestNotDeepEqual(t *testing.T) {
 	assert.NotDeepEqual(fake(t, "** got [12 34], wanted anything else"), []int{12, 34}, []int{12, 34})
 }
 
+func TestGreater(t *testing.T) {
+	assert.Greater(noerr(t), 10, 5)
+	assert.Greater(fake(t, "** got 3, wanted > 5"), 3, 5)
+	assert.Greater(fake(t, "** got 5, wanted > 5"), 5, 5)
+}
+
+func TestLess(t *testing.T) {
+	assert.Less(noerr(t), 3, 5)
+	assert.Less(fake(t, "** got 10, wanted < 5"), 10, 5)
+	assert.Less(fake(t, "** got 5, wanted < 5"), 5, 5)
+}
+
+func TestGreaterOrEq(t *testing.T) {
+	assert.GreaterOrEq(noerr(t), 10, 5)
+	assert.GreaterOrEq(noerr(t), 5, 5)
+	assert.GreaterOrEq(fake(t, "** got 3, wanted >= 5"), 3, 5)
+}
+
+func TestLessOrEq(t *testing.T) {
+	assert.LessOrEq(noerr(t), 3, 5)
+	assert.LessOrEq(noerr(t), 5, 5)
+	assert.LessOrEq(fake(t, "** got 10, wanted <= 5"), 10, 5)
+}
+
+func TestBetween(t *testing.T) {
+	assert.Between(noerr(t), 3, 0, 5)
+	assert.Between(noerr(t), 0, 0, 5)
+	assert.Between(noerr(t), 5, 0, 5)
+	assert.Between(fake(t, "** got 10, wanted in [0, 5]"), 10, 0, 5)
+	assert.Between(fake(t, "** got -1, wanted in [0, 5]"), -1, 0, 5)
+}
+
+func TestCmp(t *testing.T) {
+	cmp := func(a, e int) int { return a - e }
+	assert.Cmp(noerr(t), 42, 42, cmp)
+	assert.Cmp(fake(t, "** got 10, wanted 42"), 10, 42, cmp)
+}
+
+func TestInDelta(t *testing.T) {
+	assert.InDelta(noerr(t), 1, 1.0000001, 1e-6)
+	assert.InDelta(fake(t, "** got 1.25, wanted 1 ± 0.1 (actual delta: 0.25)"), 1.25, 1, 0.1)
+	assert.InDelta(noerr(t), math.Inf(1), math.Inf(1), 1e-6)
+	assert.InDelta(fake(t, "** got NaN, wanted 1 ± 1e-06 (NaN is never within any delta)"), math.NaN(), 1, 1e-6)
+}
+
+func TestInEpsilon(t *testing.T) {
+	assert.InEpsilon(noerr(t), 100, 100.00001, 1e-3)
+	assert.InEpsilon(fake(t, "** got 110, wanted 100 ± 0.01 (actual epsilon: 0.1)"), 110, 100, 1e-2)
+	assert.InEpsilon(noerr(t), math.Inf(1), math.Inf(1), 1e-6)
+	assert.InEpsilon(fake(t, "** got +Inf, wanted -Inf ± 1e-06 (actual epsilon: NaN)"), math.Inf(1), math.Inf(-1), 1e-6)
+	assert.InEpsilon(fake(t, "** got NaN, wanted 1 ± 1e-06 (NaN is never within any epsilon)"), math.NaN(), 1, 1e-6)
+	assert.InEpsilon(fake(t, "** got 1, wanted 0 (relative error is undefined when wanted value is 0; use InDelta instead)"), 1, 0, 1e-6)
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	assert.InDeltaSlice(noerr(t), []float64{1, 2, 3}, []float64{1, 2, 3.0000001}, 1e-6)
+	assert.InDeltaSlice(fake(t, "** got slice of length 2, wanted length 3"), []float64{1, 2}, []float64{1, 2, 3}, 1e-6)
+	assert.InDeltaSlice(fake(t, "** got 2.1 at index 1, wanted 2 ± 1e-06"), []float64{1, 2.1}, []float64{1, 2}, 1e-6)
+}
+
+func TestInEpsilonSlice(t *testing.T) {
+	assert.InEpsilonSlice(noerr(t), []float64{100, 200}, []float64{100, 200.00001}, 1e-3)
+	assert.InEpsilonSlice(fake(t, "** got slice of length 2, wanted length 3"), []float64{1, 2}, []float64{1, 2, 3}, 1e-6)
+	assert.InEpsilonSlice(fake(t, "** got 220 at index 1, wanted 200 ± 0.01"), []float64{100, 220}, []float64{100, 200}, 1e-2)
+}
+
+func TestContainsSubstring(t *testing.T) {
+	assert.ContainsSubstring(noerr(t), "hello world", "world")
+	assert.ContainsSubstring(fake(t, `** got "hello world", wanted to contain "xyz"`), "hello world", "xyz")
+}
+
+func TestNotContainsSubstring(t *testing.T) {
+	assert.NotContainsSubstring(noerr(t), "hello world", "xyz")
+	assert.NotContainsSubstring(fake(t, `** got "hello world", wanted to not contain "world"`), "hello world", "world")
+}
+
+func TestContainsElement(t *testing.T) {
+	assert.ContainsElement(noerr(t), []int{1, 2, 3}, 2)
+	assert.ContainsElement(fake(t, "** got [1 2 3], wanted to contain 4"), []int{1, 2, 3}, 4)
+}
+
+func TestContainsElement_truncated(t *testing.T) {
+	long := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.ContainsElement(fake(t, "** got [0 1 2 ... 8 9 10], wanted to contain 99"), long, 99)
+}
+
+func TestNotContainsElement(t *testing.T) {
+	assert.NotContainsElement(noerr(t), []int{1, 2, 3}, 4)
+	assert.NotContainsElement(fake(t, "** got [1 2 3], wanted to not contain 2"), []int{1, 2, 3}, 2)
+}
+
+func TestContainsElementFunc(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	assert.ContainsElementFunc(noerr(t), []int{1, 3, 4}, isEven)
+	assert.ContainsElementFunc(fake(t, "** got [1 3 5], wanted an element matching the predicate"), []int{1, 3, 5}, isEven)
+}
+
+func TestNotContainsElementFunc(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	assert.NotContainsElementFunc(noerr(t), []int{1, 3, 5}, isEven)
+	assert.NotContainsElementFunc(fake(t, "** got [1 3 4], wanted no element matching the predicate"), []int{1, 3, 4}, isEven)
+}
+
+func TestContainsKey(t *testing.T) {
+	assert.ContainsKey(noerr(t), map[string]int{"a": 1}, "a")
+	assert.ContainsKey(fake(t, "** got map[a:1], wanted to contain key b"), map[string]int{"a": 1}, "b")
+}
+
+func TestNotContainsKey(t *testing.T) {
+	assert.NotContainsKey(noerr(t), map[string]int{"a": 1}, "b")
+	assert.NotContainsKey(fake(t, "** got map[a:1], wanted to not contain key a"), map[string]int{"a": 1}, "a")
+}
+
+func TestEventually(t *testing.T) {
+	n := 0
+	assert.Eventually(noerr(t), func() bool { n++; return n >= 3 }, time.Second, time.Millisecond)
+
+	assert.Eventually(fake(t, "** timed out after 10ms waiting for condition to become true"), func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+
+	assert.Eventually(fake(t, "** condition panicked: boom"), func() bool { panic("boom") }, time.Second, time.Millisecond)
+}
+
+func TestNever(t *testing.T) {
+	assert.Never(noerr(t), func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+
+	assert.Never(fake(t, "** got condition become true, wanted it to stay false for 10ms"), func() bool { return true }, 10*time.Millisecond, time.Millisecond)
+
+	assert.Never(fake(t, "** condition panicked: boom"), func() bool { panic("boom") }, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestEventuallyEq(t *testing.T) {
+	n := 0
+	assert.EventuallyEq(noerr(t), func() int { n++; return n }, 3, time.Second, time.Millisecond)
+
+	assert.EventuallyEq(fake(t, "** timed out after 10ms; last got 3, wanted 5"), func() int { return 3 }, 5, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestDeepEqual_diff(t *testing.T) {
+	a := "line1\nline2\nline3"
+	e := "line1\nlineX\nline3"
+	assert.DeepEqual(fake(t, "** \n@@ -1,3 +1,3 @@\n  line1\n- line2\n+ lineX\n  line3"), a, e)
+}
+
+func TestEq_string_diff(t *testing.T) {
+	a := strings.Repeat("a", 90)
+	e := strings.Repeat("b", 90)
+	assert.Eq(fake(t, "** \n@@ -1,1 +1,1 @@\n- "+a+"\n+ "+e), a, e)
+}
+
+func TestSetFormatter(t *testing.T) {
+	t.Cleanup(func() {
+		assert.SetFormatter(func(v any) string { return fmt.Sprintf("%+v", v) })
+	})
+	type point struct{ X, Y int }
+	assert.SetFormatter(func(v any) string { return fmt.Sprintf("%#v", v) })
+	assert.DeepEqual(fake(t, "** got assert_test.point{X:1, Y:2}, wanted assert_test.point{X:9, Y:9}"), point{1, 2}, point{9, 9})
+}
+
+func TestFor(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.OK(true)
+}
+
+func TestFor_prefix(t *testing.T) {
+	a := assert.For(fake(t, "** user 42: got false, wanted true"), "user %d", 42)
+	a.OK(false)
+}
+
+func TestFor_callMessage(t *testing.T) {
+	a := assert.For(fake(t, "** user 42: retry 1: got false, wanted true"), "user %d", 42)
+	a.OK(false, "retry %d", 1)
+}
+
+func TestFor_scope(t *testing.T) {
+	a := assert.For(fake(t, "** user 42: payload: got false, wanted true"), "user %d", 42)
+	sub := a.Scope("payload")
+	sub.OK(false)
+}
+
+func TestFor_EqF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.EqF(a, 42, 42)
+	a2 := assert.For(fake(t, "** user 42: got 10, wanted 42"), "user %d", 42)
+	assert.EqF(a2, 10, 42)
+}
+
+func TestFor_False(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.False(false)
+	a2 := assert.For(fake(t, "** user 42: got true, wanted false"), "user %d", 42)
+	a2.False(true)
+}
+
+func TestFor_Success(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.Success(nil)
+	a2 := assert.For(fake(t, "** user 42: failed: file does not exist"), "user %d", 42)
+	a2.Success(fs.ErrNotExist)
+}
+
+func TestFor_Error(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.Error(fs.ErrNotExist, fs.ErrNotExist)
+	a2 := assert.For(fake(t, "** user 42: failed with: file already exists, wanted: file does not exist"), "user %d", 42)
+	a2.Error(fs.ErrExist, fs.ErrNotExist)
+}
+
+func TestFor_ErrorMsg(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.ErrorMsg(fs.ErrNotExist, "file does not exist")
+	a2 := assert.For(fake(t, "** user 42: failed with: file already exists, wanted: file does not exist"), "user %d", 42)
+	a2.ErrorMsg(fs.ErrExist, "file does not exist")
+}
+
+func TestFor_PanicMsg(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.PanicMsg(panickyFunc, "runtime error: index out of range [2] with length 2")
+	a2 := assert.For(fake(t, "** user 42: paniced with: runtime error: index out of range [2] with length 2, wanted: foo"), "user %d", 42)
+	a2.PanicMsg(panickyFunc, "foo")
+}
+
+func TestFor_ContainsSubstring(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.ContainsSubstring("hello world", "world")
+	a2 := assert.For(fake(t, `** user 42: got "hello world", wanted to contain "xyz"`), "user %d", 42)
+	a2.ContainsSubstring("hello world", "xyz")
+}
+
+func TestFor_NotContainsSubstring(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.NotContainsSubstring("hello world", "xyz")
+	a2 := assert.For(fake(t, `** user 42: got "hello world", wanted to not contain "world"`), "user %d", 42)
+	a2.NotContainsSubstring("hello world", "world")
+}
+
+func TestFor_InDelta(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.InDelta(1, 1.0000001, 1e-6)
+	a2 := assert.For(fake(t, "** user 42: got 1.25, wanted 1 ± 0.1 (actual delta: 0.25)"), "user %d", 42)
+	a2.InDelta(1.25, 1, 0.1)
+}
+
+func TestFor_InEpsilon(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.InEpsilon(100, 100.00001, 1e-3)
+	a2 := assert.For(fake(t, "** user 42: got 110, wanted 100 ± 0.01 (actual epsilon: 0.1)"), "user %d", 42)
+	a2.InEpsilon(110, 100, 1e-2)
+}
+
+func TestFor_InDeltaSlice(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.InDeltaSlice([]float64{1, 2, 3}, []float64{1, 2, 3.0000001}, 1e-6)
+	a2 := assert.For(fake(t, "** user 42: got 2.1 at index 1, wanted 2 ± 1e-06"), "user %d", 42)
+	a2.InDeltaSlice([]float64{1, 2.1}, []float64{1, 2}, 1e-6)
+}
+
+func TestFor_InEpsilonSlice(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.InEpsilonSlice([]float64{100, 200}, []float64{100, 200.00001}, 1e-3)
+	a2 := assert.For(fake(t, "** user 42: got 220 at index 1, wanted 200 ± 0.01"), "user %d", 42)
+	a2.InEpsilonSlice([]float64{100, 220}, []float64{100, 200}, 1e-2)
+}
+
+func TestFor_Eventually(t *testing.T) {
+	a := assert.For(noerr(t))
+	n := 0
+	a.Eventually(func() bool { n++; return n >= 3 }, time.Second, time.Millisecond)
+	a2 := assert.For(fake(t, "** user 42: timed out after 10ms waiting for condition to become true"), "user %d", 42)
+	a2.Eventually(func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestFor_Never(t *testing.T) {
+	a := assert.For(noerr(t))
+	a.Never(func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	a2 := assert.For(fake(t, "** user 42: got condition become true, wanted it to stay false for 10ms"), "user %d", 42)
+	a2.Never(func() bool { return true }, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestFor_NotEqF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NotEqF(a, 12, 42)
+	a2 := assert.For(fake(t, "** user 42: got 42, wanted anything else"), "user %d", 42)
+	assert.NotEqF(a2, 42, 42)
+}
+
+func TestFor_DeepEqualF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.DeepEqualF(a, []int{12, 34}, []int{12, 34})
+	a2 := assert.For(fake(t, "** user 42: got [42], wanted [12 34]"), "user %d", 42)
+	assert.DeepEqualF(a2, []int{42}, []int{12, 34})
+}
+
+func TestFor_NotDeepEqualF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NotDeepEqualF(a, []int{12, 34}, []int{12})
+	a2 := assert.For(fake(t, "** user 42: got [12 34], wanted anything else"), "user %d", 42)
+	assert.NotDeepEqualF(a2, []int{12, 34}, []int{12, 34})
+}
+
+func TestFor_MethodEqualF(t *testing.T) {
+	got := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := assert.For(noerr(t))
+	assert.MethodEqualF(a, got, got)
+	a2 := assert.For(fake(t, "** user 42: got 2023-01-01 00:00:00 +0000 UTC, wanted 2024-01-01 00:00:00 +0000 UTC"), "user %d", 42)
+	assert.MethodEqualF(a2, got, want)
+}
+
+func TestFor_NotMethodEqualF(t *testing.T) {
+	got := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a := assert.For(noerr(t))
+	assert.NotMethodEqualF(a, got, want)
+	a2 := assert.For(fake(t, "** user 42: got 2023-01-01 00:00:00 +0000 UTC, wanted anything else"), "user %d", 42)
+	assert.NotMethodEqualF(a2, got, got)
+}
+
+func TestFor_NilF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NilF(a, (*int)(nil))
+	v := 42
+	a2 := assert.For(fake(t, "** user 42: got &42, wanted nil"), "user %d", 42)
+	assert.NilF(a2, &v)
+}
+
+func TestFor_NonNilF(t *testing.T) {
+	v := 42
+	a := assert.For(noerr(t))
+	assert.NonNilF(a, &v)
+	a2 := assert.For(fake(t, "** user 42: got nil *int, wanted non-nil"), "user %d", 42)
+	assert.NonNilF(a2, (*int)(nil))
+}
+
+func TestFor_ZeroF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.ZeroF(a, 0)
+	a2 := assert.For(fake(t, "** user 42: got 42, wanted zero value 0"), "user %d", 42)
+	assert.ZeroF(a2, 42)
+}
+
+func TestFor_NonZeroF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NonZeroF(a, 42)
+	a2 := assert.For(fake(t, "** user 42: got zero value 0, wanted non-zero"), "user %d", 42)
+	assert.NonZeroF(a2, 0)
+}
+
+func TestFor_EmptySliceF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.EmptySliceF(a, []int(nil))
+	a2 := assert.For(fake(t, "** user 42: got [42], wanted empty slice"), "user %d", 42)
+	assert.EmptySliceF(a2, []int{42})
+}
+
+func TestFor_NonEmptySliceF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NonEmptySliceF(a, []int{42})
+	a2 := assert.For(fake(t, "** user 42: got empty []int, wanted non-empty"), "user %d", 42)
+	assert.NonEmptySliceF(a2, []int{})
+}
+
+func TestFor_EmptyMapF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.EmptyMapF(a, map[int]string(nil))
+	a2 := assert.For(fake(t, "** user 42: got map[42:x], wanted empty map"), "user %d", 42)
+	assert.EmptyMapF(a2, map[int]string{42: "x"})
+}
+
+func TestFor_NonEmptyMapF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NonEmptyMapF(a, map[int]string{42: "x"})
+	a2 := assert.For(fake(t, "** user 42: got empty map[int]string, wanted non-empty"), "user %d", 42)
+	assert.NonEmptyMapF(a2, map[int]string{})
+}
+
+func TestFor_GreaterF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.GreaterF(a, 10, 5)
+	a2 := assert.For(fake(t, "** user 42: got 3, wanted > 5"), "user %d", 42)
+	assert.GreaterF(a2, 3, 5)
+}
+
+func TestFor_LessF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.LessF(a, 3, 5)
+	a2 := assert.For(fake(t, "** user 42: got 10, wanted < 5"), "user %d", 42)
+	assert.LessF(a2, 10, 5)
+}
+
+func TestFor_GreaterOrEqF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.GreaterOrEqF(a, 5, 5)
+	a2 := assert.For(fake(t, "** user 42: got 3, wanted >= 5"), "user %d", 42)
+	assert.GreaterOrEqF(a2, 3, 5)
+}
+
+func TestFor_LessOrEqF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.LessOrEqF(a, 5, 5)
+	a2 := assert.For(fake(t, "** user 42: got 10, wanted <= 5"), "user %d", 42)
+	assert.LessOrEqF(a2, 10, 5)
+}
+
+func TestFor_BetweenF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.BetweenF(a, 3, 0, 5)
+	a2 := assert.For(fake(t, "** user 42: got 10, wanted in [0, 5]"), "user %d", 42)
+	assert.BetweenF(a2, 10, 0, 5)
+}
+
+func TestFor_CmpF(t *testing.T) {
+	cmp := func(a, e int) int { return a - e }
+	a := assert.For(noerr(t))
+	assert.CmpF(a, 42, 42, cmp)
+	a2 := assert.For(fake(t, "** user 42: got 10, wanted 42"), "user %d", 42)
+	assert.CmpF(a2, 10, 42, cmp)
+}
+
+func TestFor_ContainsElementF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.ContainsElementF(a, []int{1, 2, 3}, 2)
+	a2 := assert.For(fake(t, "** user 42: got [1 2 3], wanted to contain 4"), "user %d", 42)
+	assert.ContainsElementF(a2, []int{1, 2, 3}, 4)
+}
+
+func TestFor_NotContainsElementF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NotContainsElementF(a, []int{1, 2, 3}, 4)
+	a2 := assert.For(fake(t, "** user 42: got [1 2 3], wanted to not contain 2"), "user %d", 42)
+	assert.NotContainsElementF(a2, []int{1, 2, 3}, 2)
+}
+
+func TestFor_ContainsElementFuncF(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	a := assert.For(noerr(t))
+	assert.ContainsElementFuncF(a, []int{1, 3, 4}, isEven)
+	a2 := assert.For(fake(t, "** user 42: got [1 3 5], wanted an element matching the predicate"), "user %d", 42)
+	assert.ContainsElementFuncF(a2, []int{1, 3, 5}, isEven)
+}
+
+func TestFor_NotContainsElementFuncF(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	a := assert.For(noerr(t))
+	assert.NotContainsElementFuncF(a, []int{1, 3, 5}, isEven)
+	a2 := assert.For(fake(t, "** user 42: got [1 3 4], wanted no element matching the predicate"), "user %d", 42)
+	assert.NotContainsElementFuncF(a2, []int{1, 3, 4}, isEven)
+}
+
+func TestFor_ContainsKeyF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.ContainsKeyF(a, map[string]int{"a": 1}, "a")
+	a2 := assert.For(fake(t, "** user 42: got map[a:1], wanted to contain key b"), "user %d", 42)
+	assert.ContainsKeyF(a2, map[string]int{"a": 1}, "b")
+}
+
+func TestFor_NotContainsKeyF(t *testing.T) {
+	a := assert.For(noerr(t))
+	assert.NotContainsKeyF(a, map[string]int{"a": 1}, "b")
+	a2 := assert.For(fake(t, "** user 42: got map[a:1], wanted to not contain key a"), "user %d", 42)
+	assert.NotContainsKeyF(a2, map[string]int{"a": 1}, "a")
+}
+
+func TestFor_EventuallyEqF(t *testing.T) {
+	n := 0
+	a := assert.For(noerr(t))
+	assert.EventuallyEqF(a, func() int { n++; return n }, 3, time.Second, time.Millisecond)
+	a2 := assert.For(fake(t, "** user 42: timed out after 10ms; last got 3, wanted 5"), "user %d", 42)
+	assert.EventuallyEqF(a2, func() int { return 3 }, 5, 10*time.Millisecond, time.Millisecond)
+}
+
 func TestNil(t *testing.T) {
 	assert.Nil(noerr(t), (*int)(nil))
 	v := 42
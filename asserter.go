@@ -0,0 +1,294 @@
+package assert
+
+import (
+	"cmp"
+	"time"
+)
+
+// Asserter binds a TB and an accumulated message prefix, so that a chain of
+// assertions doesn't need to repeat it. Create one with For.
+type Asserter struct {
+	t      TB
+	prefix []any
+}
+
+// For returns an Asserter bound to t, with messageAndArgs establishing a
+// message prefix shared by every assertion made through it, e.g.:
+//
+//	a := assert.For(t, "user %d", id)
+//	a.OK(found)
+//	assert.EqF(a, got, want)
+//	assert.NonNilF(a, ptr)
+func For(t TB, messageAndArgs ...any) *Asserter {
+	return &Asserter{t: t, prefix: messageAndArgs}
+}
+
+// Scope returns a copy of a with prefix nested under a's existing prefix,
+// e.g. a.Scope("payload") turns a prefix of "user 42" into "user 42: payload".
+func (a *Asserter) Scope(prefix string, args ...any) *Asserter {
+	seed := append([]any{prefix}, args...)
+	return &Asserter{t: a.t, prefix: mergePrefix(a.prefix, seed)}
+}
+
+// mergePrefix combines an outer (already accumulated) messageAndArgs prefix
+// with an inner one supplied for a single call, keeping the outer context
+// first, e.g. mergePrefix(["user %d", 42], ["payload"]) renders as
+// "user 42: payload: ".
+func mergePrefix(outer, inner []any) []any {
+	if len(outer) == 0 {
+		return inner
+	}
+	if len(inner) == 0 {
+		return outer
+	}
+	return AddPrefix(inner, outer[0].(string), outer[1:]...)
+}
+
+// OK asserts that the value is true.
+func (a *Asserter) OK(v bool, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return OK(a.t, v, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// False asserts that the value is false.
+func (a *Asserter) False(v bool, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return False(a.t, v, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// Success asserts that the error is nil.
+func (a *Asserter) Success(err error, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Success(a.t, err, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// Error asserts that the actual error value is equivalent to the expected
+// error value using errors.Is.
+func (a *Asserter) Error(got, want error, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Error(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// ErrorMsg asserts that the actual error message is equivalent to the expected one.
+func (a *Asserter) ErrorMsg(got error, want string, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return ErrorMsg(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// PanicMsg asserts that a function panics with the given message.
+func (a *Asserter) PanicMsg(f func(), want string, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return PanicMsg(a.t, f, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// ContainsSubstring asserts that haystack contains needle as a substring.
+func (a *Asserter) ContainsSubstring(haystack, needle string, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return ContainsSubstring(a.t, haystack, needle, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotContainsSubstring asserts that haystack does not contain needle as a substring.
+func (a *Asserter) NotContainsSubstring(haystack, needle string, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotContainsSubstring(a.t, haystack, needle, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// InDelta asserts that got and want differ by no more than delta.
+func (a *Asserter) InDelta(got, want, delta float64, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return InDelta(a.t, got, want, delta, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// InEpsilon asserts that got and want differ by no more than epsilon as a fraction of want.
+func (a *Asserter) InEpsilon(got, want, epsilon float64, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return InEpsilon(a.t, got, want, epsilon, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// InDeltaSlice asserts that got and want have the same length and are element-wise within delta.
+func (a *Asserter) InDeltaSlice(got, want []float64, delta float64, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return InDeltaSlice(a.t, got, want, delta, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// InEpsilonSlice asserts that got and want have the same length and are element-wise within epsilon.
+func (a *Asserter) InEpsilonSlice(got, want []float64, epsilon float64, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return InEpsilonSlice(a.t, got, want, epsilon, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// Eventually polls cond every tick until it returns true or timeout elapses.
+func (a *Asserter) Eventually(cond func() bool, timeout, tick time.Duration, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Eventually(a.t, cond, timeout, tick, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// Never polls cond every tick for duration and fails if it ever returns true.
+func (a *Asserter) Never(cond func() bool, duration, tick time.Duration, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Never(a.t, cond, duration, tick, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// EqF asserts that two values are equal via == operator. Exposed as a free
+// function, rather than a method on *Asserter, because Go methods can't
+// take their own type parameters.
+func EqF[T comparable](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Eq(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotEqF asserts that two values are not equal via != operator.
+func NotEqF[T comparable](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotEq(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// DeepEqualF asserts that two values are equal via reflect.DeepEqual.
+func DeepEqualF[T any](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return DeepEqual(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotDeepEqualF asserts that two values are not equal via !reflect.DeepEqual.
+func NotDeepEqualF[T any](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotDeepEqual(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// MethodEqualF asserts that two values are equal via their Equal method (like time.Time).
+func MethodEqualF[T interface{ Equal(T) bool }](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return MethodEqual(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotMethodEqualF asserts that two values are not equal via their Equal method (like time.Time).
+func NotMethodEqualF[T interface{ Equal(T) bool }](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotMethodEqual(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NilF asserts that a pointer value is nil.
+func NilF[T any, P ~*T](a *Asserter, got P, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Nil(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NonNilF asserts that a pointer value is anything but nil.
+func NonNilF[T any](a *Asserter, got *T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NonNil(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// ZeroF asserts that the value is equal to a zero value for its type using == operator.
+func ZeroF[T comparable](a *Asserter, got T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Zero(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NonZeroF asserts that the value is not equal to a zero value for its type using != operator.
+func NonZeroF[T comparable](a *Asserter, got T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NonZero(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// EmptySliceF asserts that the given slice is nil or empty.
+func EmptySliceF[T any, S ~[]T](a *Asserter, got S, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return EmptySlice(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NonEmptySliceF asserts that the given slice has non-zero length.
+func NonEmptySliceF[T any, S ~[]T](a *Asserter, got S, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NonEmptySlice(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// EmptyMapF asserts that the given map is nil or empty.
+func EmptyMapF[K comparable, V any, M ~map[K]V](a *Asserter, got M, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return EmptyMap(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NonEmptyMapF asserts that the given map has non-zero length.
+func NonEmptyMapF[K comparable, V any, M ~map[K]V](a *Asserter, got M, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NonEmptyMap(a.t, got, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// GreaterF asserts that got > want.
+func GreaterF[T cmp.Ordered](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Greater(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// LessF asserts that got < want.
+func LessF[T cmp.Ordered](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Less(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// GreaterOrEqF asserts that got >= want.
+func GreaterOrEqF[T cmp.Ordered](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return GreaterOrEq(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// LessOrEqF asserts that got <= want.
+func LessOrEqF[T cmp.Ordered](a *Asserter, got, want T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return LessOrEq(a.t, got, want, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// BetweenF asserts that lo <= got <= hi.
+func BetweenF[T cmp.Ordered](a *Asserter, got, lo, hi T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Between(a.t, got, lo, hi, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// CmpF asserts that two values are equal according to the given comparison function.
+func CmpF[T any](a *Asserter, got, want T, cmp func(a, e T) int, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return Cmp(a.t, got, want, cmp, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// ContainsElementF asserts that haystack contains needle as one of its elements.
+func ContainsElementF[T comparable, S ~[]T](a *Asserter, haystack S, needle T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return ContainsElement(a.t, haystack, needle, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotContainsElementF asserts that haystack does not contain needle among its elements.
+func NotContainsElementF[T comparable, S ~[]T](a *Asserter, haystack S, needle T, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotContainsElement(a.t, haystack, needle, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// ContainsElementFuncF asserts that haystack contains an element satisfying pred.
+func ContainsElementFuncF[T any, S ~[]T](a *Asserter, haystack S, pred func(T) bool, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return ContainsElementFunc(a.t, haystack, pred, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotContainsElementFuncF asserts that no element of haystack satisfies pred.
+func NotContainsElementFuncF[T any, S ~[]T](a *Asserter, haystack S, pred func(T) bool, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotContainsElementFunc(a.t, haystack, pred, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// ContainsKeyF asserts that the given map has an entry for key.
+func ContainsKeyF[K comparable, V any, M ~map[K]V](a *Asserter, m M, key K, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return ContainsKey(a.t, m, key, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// NotContainsKeyF asserts that the given map has no entry for key.
+func NotContainsKeyF[K comparable, V any, M ~map[K]V](a *Asserter, m M, key K, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return NotContainsKey(a.t, m, key, mergePrefix(a.prefix, messageAndArgs)...)
+}
+
+// EventuallyEqF polls get every tick until it returns want or timeout elapses.
+func EventuallyEqF[T comparable](a *Asserter, get func() T, want T, timeout, tick time.Duration, messageAndArgs ...any) bool {
+	a.t.Helper()
+	return EventuallyEq(a.t, get, want, timeout, tick, mergePrefix(a.prefix, messageAndArgs)...)
+}
@@ -0,0 +1,157 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffThreshold is the length (in bytes) above which a formatted value
+// is considered "large" and rendered as a unified diff instead of inline.
+const diffThreshold = 80
+
+// diffContext is the number of unchanged lines of context kept around each
+// diff hunk.
+const diffContext = 3
+
+// formatter renders a value for use in assertion error messages. Override it
+// with SetFormatter to plug in go-spew, pp, or similar.
+var formatter = func(v any) string {
+	return fmt.Sprintf("%+v", v)
+}
+
+// SetFormatter overrides the function used to render values in assertion
+// error messages (defaults to fmt.Sprintf("%+v", v)).
+func SetFormatter(f func(any) string) {
+	formatter = f
+}
+
+// needsDiff reports whether either formatted value is large enough, or
+// already multi-line, to warrant a unified diff instead of an inline message.
+func needsDiff(a, e string) bool {
+	return len(a) > diffThreshold || len(e) > diffThreshold || strings.Contains(a, "\n") || strings.Contains(e, "\n")
+}
+
+// diffOrCompact renders a got/wanted message, switching to a unified diff
+// when the values are large (see needsDiff).
+func diffOrCompact(a, e string) string {
+	if needsDiff(a, e) {
+		return "\n" + unifiedDiff(a, e)
+	}
+	return fmt.Sprintf("got %s, wanted %s", a, e)
+}
+
+// unifiedDiff renders a line-by-line unified diff between actual and
+// expected, e.g. as produced by `diff -u`.
+func unifiedDiff(actual, expected string) string {
+	ops := diffLines(strings.Split(actual, "\n"), strings.Split(expected, "\n"))
+	return renderHunks(ops)
+}
+
+// diffOp is one line of an edit script turning a into e.
+type diffOp struct {
+	kind byte // ' ' unchanged, '-' only in actual, '+' only in expected
+	line string
+}
+
+// diffLines computes a minimal edit script turning a into e, via the
+// standard LCS-based diff algorithm (as used by Myers' diff and `diff -u`).
+func diffLines(a, e []string) []diffOp {
+	n, m := len(a), len(e)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == e[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == e[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', e[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{'+', e[j]})
+	}
+	return ops
+}
+
+// renderHunks groups an edit script into @@ -a,b +c,d @@ hunks with up to
+// diffContext lines of unchanged context around each changed region,
+// merging regions that are close enough together to share context.
+func renderHunks(ops []diffOp) string {
+	n := len(ops)
+	oldLine, newLine := make([]int, n+1), make([]int, n+1)
+	for i, op := range ops {
+		oldLine[i+1] = oldLine[i]
+		newLine[i+1] = newLine[i]
+		if op.kind != '+' {
+			oldLine[i+1]++
+		}
+		if op.kind != '-' {
+			newLine[i+1]++
+		}
+	}
+
+	var regions [][2]int
+	for i := 0; i < n; {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := i
+		for i < n && ops[i].kind != ' ' {
+			i++
+		}
+		if len(regions) > 0 && start-regions[len(regions)-1][1] <= 2*diffContext {
+			regions[len(regions)-1][1] = i
+		} else {
+			regions = append(regions, [2]int{start, i})
+		}
+	}
+
+	var b strings.Builder
+	for _, r := range regions {
+		start, end := r[0]-diffContext, r[1]+diffContext
+		if start < 0 {
+			start = 0
+		}
+		if end > n {
+			end = n
+		}
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", oldLine[start]+1, oldLine[end]-oldLine[start], newLine[start]+1, newLine[end]-newLine[start])
+		for _, op := range ops[start:end] {
+			switch op.kind {
+			case ' ':
+				fmt.Fprintf(&b, "  %s\n", op.line)
+			case '-':
+				fmt.Fprintf(&b, "- %s\n", op.line)
+			case '+':
+				fmt.Fprintf(&b, "+ %s\n", op.line)
+			}
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
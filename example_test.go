@@ -2,7 +2,6 @@ package assert_test
 
 import (
 	"io/fs"
-	"math"
 	"testing"
 
 	"github.com/andreyvit/assert"
@@ -49,19 +48,8 @@ func Example() {
 	assert.Error(t, err, fs.ErrNotExist)
 	assert.ErrorMsg(t, err, "file does not exist")
 	assert.PanicMsg(t, panickyFunc, "runtime error: index out of range [2] with length 2")
-}
-
-func TestApproxEq(t *testing.T) {
-	approxEq(noerr(t), 1, 1.0000001)
-	approxEq(fake(t, "** got 1, wanted 1.0001 ± 1e-06"), 1, 1.0001)
-}
 
-func approxEq(t assert.TB, a, e float64, messageAndArgs ...any) bool {
-	const eps = 1e-6
-	if math.Abs(a-e) > eps {
-		t.Helper()
-		t.Errorf("** %sgot %v, wanted %v ± %v", assert.FormatPrefix(messageAndArgs), a, e, eps)
-		return false
-	}
-	return true
+	var amount float64
+	assert.InDelta(t, amount, 42, 1e-6)
+	assert.InEpsilon(t, amount, 42, 1e-6)
 }
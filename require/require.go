@@ -0,0 +1,450 @@
+// Package require mirrors the github.com/andreyvit/assert API, except that
+// every assertion calls FailNow (e.g. via t.FailNow) on failure instead of
+// just recording an error, so tests abort immediately rather than continuing
+// with a precondition that didn't hold.
+//
+// This is useful when a failed assertion would make subsequent code panic,
+// e.g. require.NonNil(t, x) before dereferencing x.
+package require
+
+import (
+	"cmp"
+	"time"
+
+	"github.com/andreyvit/assert"
+)
+
+// TB contains the parts of testing.TB that this package actually needs. Pass *testing.T or *testing.B for arguments of type TB.
+type TB interface {
+	assert.TB
+	FailNow()
+}
+
+// OK asserts that the value is true, stopping the test immediately if not.
+func OK(t TB, a bool, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.OK(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// False asserts that the value is false, stopping the test immediately if not.
+func False(t TB, a bool, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.False(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Eq asserts that two values are equal via == operator, stopping the test immediately if not.
+func Eq[T comparable](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Eq(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotEq asserts that two values are not equal via != operator, stopping the test immediately if not.
+func NotEq[T comparable](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotEq(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// DeepEqual asserts that two values are equal via reflect.DeepEqual, stopping the test immediately if not.
+func DeepEqual[T any](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.DeepEqual(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotDeepEqual asserts that two values are not equal via !reflect.DeepEqual, stopping the test immediately if not.
+func NotDeepEqual[T any](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotDeepEqual(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// MethodEqual asserts that two values are equal via their Equal method (like time.Time), stopping the test immediately if not.
+func MethodEqual[T interface{ Equal(T) bool }](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.MethodEqual(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotMethodEqual asserts that two values are not equal via their Equal method (like time.Time), stopping the test immediately if not.
+func NotMethodEqual[T interface{ Equal(T) bool }](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotMethodEqual(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Greater asserts that a > e, stopping the test immediately if not.
+func Greater[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Greater(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Less asserts that a < e, stopping the test immediately if not.
+func Less[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Less(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// GreaterOrEq asserts that a >= e, stopping the test immediately if not.
+func GreaterOrEq[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.GreaterOrEq(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// LessOrEq asserts that a <= e, stopping the test immediately if not.
+func LessOrEq[T cmp.Ordered](t TB, a, e T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.LessOrEq(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Between asserts that lo <= a <= hi, stopping the test immediately if not.
+func Between[T cmp.Ordered](t TB, a, lo, hi T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Between(t, a, lo, hi, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Cmp asserts that two values are equal according to the given comparison
+// function, which should return 0 for equal values (like (*big.Int).Cmp or
+// time.Time.Compare), stopping the test immediately if not.
+func Cmp[T any](t TB, a, e T, cmp func(a, e T) int, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Cmp(t, a, e, cmp, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// InDelta asserts that a and e differ by no more than delta (see
+// assert.InDelta), stopping the test immediately if not.
+func InDelta(t TB, a, e, delta float64, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.InDelta(t, a, e, delta, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// InEpsilon asserts that a and e differ by no more than epsilon as a
+// fraction of e (see assert.InEpsilon), stopping the test immediately if not.
+func InEpsilon(t TB, a, e, epsilon float64, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.InEpsilon(t, a, e, epsilon, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// InDeltaSlice asserts that a and e have the same length and that each pair
+// of corresponding elements is within delta of each other (see InDelta),
+// stopping the test immediately if not.
+func InDeltaSlice(t TB, a, e []float64, delta float64, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.InDeltaSlice(t, a, e, delta, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// InEpsilonSlice asserts that a and e have the same length and that each
+// pair of corresponding elements is within epsilon of each other (see
+// InEpsilon), stopping the test immediately if not.
+func InEpsilonSlice(t TB, a, e []float64, epsilon float64, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.InEpsilonSlice(t, a, e, epsilon, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// ContainsSubstring asserts that haystack contains needle as a substring, stopping the test immediately if not.
+func ContainsSubstring(t TB, haystack, needle string, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.ContainsSubstring(t, haystack, needle, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotContainsSubstring asserts that haystack does not contain needle as a substring, stopping the test immediately if not.
+func NotContainsSubstring(t TB, haystack, needle string, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotContainsSubstring(t, haystack, needle, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// ContainsElement asserts that haystack contains needle as one of its elements, stopping the test immediately if not.
+func ContainsElement[T comparable, S ~[]T](t TB, haystack S, needle T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.ContainsElement(t, haystack, needle, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotContainsElement asserts that haystack does not contain needle among its elements, stopping the test immediately if not.
+func NotContainsElement[T comparable, S ~[]T](t TB, haystack S, needle T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotContainsElement(t, haystack, needle, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// ContainsElementFunc asserts that haystack contains an element satisfying pred, stopping the test immediately if not.
+func ContainsElementFunc[T any, S ~[]T](t TB, haystack S, pred func(T) bool, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.ContainsElementFunc(t, haystack, pred, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotContainsElementFunc asserts that no element of haystack satisfies pred, stopping the test immediately if not.
+func NotContainsElementFunc[T any, S ~[]T](t TB, haystack S, pred func(T) bool, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotContainsElementFunc(t, haystack, pred, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// ContainsKey asserts that the given map has an entry for key, stopping the test immediately if not.
+func ContainsKey[K comparable, V any, M ~map[K]V](t TB, m M, key K, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.ContainsKey(t, m, key, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NotContainsKey asserts that the given map has no entry for key, stopping the test immediately if not.
+func NotContainsKey[K comparable, V any, M ~map[K]V](t TB, m M, key K, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NotContainsKey(t, m, key, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Eventually polls cond every tick until it returns true or timeout elapses,
+// stopping the test immediately if it never does (see assert.Eventually).
+func Eventually(t TB, cond func() bool, timeout, tick time.Duration, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Eventually(t, cond, timeout, tick, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Never polls cond every tick for duration, stopping the test immediately if
+// it ever returns true (see assert.Never).
+func Never(t TB, cond func() bool, duration, tick time.Duration, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Never(t, cond, duration, tick, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// EventuallyEq polls get every tick until it returns expected or timeout
+// elapses, stopping the test immediately if it never does (see
+// assert.EventuallyEq).
+func EventuallyEq[T comparable](t TB, get func() T, expected T, timeout, tick time.Duration, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.EventuallyEq(t, get, expected, timeout, tick, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Nil asserts that a pointer value is nil, stopping the test immediately if not.
+//
+// Use Zero for interface values.
+func Nil[T any, P ~*T](t TB, a P, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Nil(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NonNil asserts that a pointer value is anything but nil, stopping the test immediately if not.
+func NonNil[T any](t TB, a *T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NonNil(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Zero asserts that the value is equal to a zero value for its type using == operator, stopping the test immediately if not.
+func Zero[T comparable](t TB, a T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Zero(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NonZero asserts that the value is not equal to a zero value for its type using != operator, stopping the test immediately if not.
+func NonZero[T comparable](t TB, a T, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NonZero(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// EmptySlice asserts that the given slice is nil or empty, stopping the test immediately if not.
+func EmptySlice[T any, S ~[]T](t TB, a S, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.EmptySlice(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NonEmptySlice asserts that the given slice has non-zero length, stopping the test immediately if not.
+func NonEmptySlice[T any, S ~[]T](t TB, a S, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NonEmptySlice(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// EmptyMap asserts that the given map is nil or empty, stopping the test immediately if not.
+func EmptyMap[K comparable, V any, M ~map[K]V](t TB, a M, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.EmptyMap(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// NonEmptyMap asserts that the given map has non-zero length, stopping the test immediately if not.
+func NonEmptyMap[K comparable, V any, M ~map[K]V](t TB, a M, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.NonEmptyMap(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Success asserts that the error is nil, stopping the test immediately if not.
+func Success(t TB, a error, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Success(t, a, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// Error asserts that the actual error value is equivalent to the expected
+// error value using errors.Is, stopping the test immediately if not.
+//
+// If the expected error is nil, behaves exactly like Success.
+func Error(t TB, a, e error, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.Error(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// ErrorMsg asserts that the actual error message is equivalent to the expected one, stopping the test immediately if not.
+//
+// If the expected error message is empty, behaves exactly like Success.
+func ErrorMsg(t TB, a error, e string, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.ErrorMsg(t, a, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
+
+// PanicMsg asserts that a function panics with the given message, stopping the test immediately if not.
+func PanicMsg(t TB, f func(), e string, messageAndArgs ...any) bool {
+	t.Helper()
+	if !assert.PanicMsg(t, f, e, messageAndArgs...) {
+		t.FailNow()
+		return false
+	}
+	return true
+}
@@ -0,0 +1,306 @@
+package require_test
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/andreyvit/assert/require"
+)
+
+func TestOK(t *testing.T) {
+	require.OK(noerr(t), true)
+	require.OK(fake(t, "** got false, wanted true"), false)
+}
+
+func TestFalse(t *testing.T) {
+	require.False(noerr(t), false)
+	require.False(fake(t, "** got true, wanted false"), true)
+}
+
+func TestEq(t *testing.T) {
+	require.Eq(noerr(t), 42, 42)
+	require.Eq(fake(t, "** got 10, wanted 42"), 10, 42)
+}
+
+func TestNotEq(t *testing.T) {
+	require.NotEq(noerr(t), 12, 42)
+	require.NotEq(fake(t, "** got 42, wanted anything else"), 42, 42)
+}
+
+func TestDeepEqual(t *testing.T) {
+	require.DeepEqual(noerr(t), []int{12, 34}, []int{12, 34})
+	require.DeepEqual(fake(t, "** got [42], wanted [12 34]"), []int{42}, []int{12, 34})
+}
+
+func TestNotDeepEqual(t *testing.T) {
+	require.NotDeepEqual(noerr(t), []int{12, 34}, []int{12})
+	require.NotDeepEqual(fake(t, "** got [12 34], wanted anything else"), []int{12, 34}, []int{12, 34})
+}
+
+func TestNil(t *testing.T) {
+	require.Nil(noerr(t), (*int)(nil))
+	v := 42
+	require.Nil(fake(t, "** got &42, wanted nil"), &v)
+}
+
+func TestNonNil(t *testing.T) {
+	v := 42
+	require.NonNil(noerr(t), &v)
+	require.NonNil(fake(t, "** got nil *int, wanted non-nil"), (*int)(nil))
+}
+
+func TestGreater(t *testing.T) {
+	require.Greater(noerr(t), 10, 5)
+	require.Greater(fake(t, "** got 3, wanted > 5"), 3, 5)
+}
+
+func TestLess(t *testing.T) {
+	require.Less(noerr(t), 3, 5)
+	require.Less(fake(t, "** got 10, wanted < 5"), 10, 5)
+}
+
+func TestGreaterOrEq(t *testing.T) {
+	require.GreaterOrEq(noerr(t), 5, 5)
+	require.GreaterOrEq(fake(t, "** got 3, wanted >= 5"), 3, 5)
+}
+
+func TestLessOrEq(t *testing.T) {
+	require.LessOrEq(noerr(t), 5, 5)
+	require.LessOrEq(fake(t, "** got 10, wanted <= 5"), 10, 5)
+}
+
+func TestBetween(t *testing.T) {
+	require.Between(noerr(t), 3, 0, 5)
+	require.Between(fake(t, "** got 10, wanted in [0, 5]"), 10, 0, 5)
+}
+
+func TestCmp(t *testing.T) {
+	cmp := func(a, e int) int { return a - e }
+	require.Cmp(noerr(t), 42, 42, cmp)
+	require.Cmp(fake(t, "** got 10, wanted 42"), 10, 42, cmp)
+}
+
+func TestInDelta(t *testing.T) {
+	require.InDelta(noerr(t), 1, 1.0000001, 1e-6)
+	require.InDelta(fake(t, "** got 1.25, wanted 1 ± 0.1 (actual delta: 0.25)"), 1.25, 1, 0.1)
+}
+
+func TestInEpsilon(t *testing.T) {
+	require.InEpsilon(noerr(t), 100, 100.00001, 1e-3)
+	require.InEpsilon(fake(t, "** got 110, wanted 100 ± 0.01 (actual epsilon: 0.1)"), 110, 100, 1e-2)
+}
+
+func TestInDeltaSlice(t *testing.T) {
+	require.InDeltaSlice(noerr(t), []float64{1, 2, 3}, []float64{1, 2, 3.0000001}, 1e-6)
+	require.InDeltaSlice(fake(t, "** got slice of length 2, wanted length 3"), []float64{1, 2}, []float64{1, 2, 3}, 1e-6)
+}
+
+func TestInEpsilonSlice(t *testing.T) {
+	require.InEpsilonSlice(noerr(t), []float64{100, 200}, []float64{100, 200.00001}, 1e-3)
+	require.InEpsilonSlice(fake(t, "** got 220 at index 1, wanted 200 ± 0.01"), []float64{100, 220}, []float64{100, 200}, 1e-2)
+}
+
+func TestContainsSubstring(t *testing.T) {
+	require.ContainsSubstring(noerr(t), "hello world", "world")
+	require.ContainsSubstring(fake(t, `** got "hello world", wanted to contain "xyz"`), "hello world", "xyz")
+}
+
+func TestNotContainsSubstring(t *testing.T) {
+	require.NotContainsSubstring(noerr(t), "hello world", "xyz")
+	require.NotContainsSubstring(fake(t, `** got "hello world", wanted to not contain "world"`), "hello world", "world")
+}
+
+func TestContainsElement(t *testing.T) {
+	require.ContainsElement(noerr(t), []int{1, 2, 3}, 2)
+	require.ContainsElement(fake(t, "** got [1 2 3], wanted to contain 4"), []int{1, 2, 3}, 4)
+}
+
+func TestNotContainsElement(t *testing.T) {
+	require.NotContainsElement(noerr(t), []int{1, 2, 3}, 4)
+	require.NotContainsElement(fake(t, "** got [1 2 3], wanted to not contain 2"), []int{1, 2, 3}, 2)
+}
+
+func TestContainsElementFunc(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	require.ContainsElementFunc(noerr(t), []int{1, 3, 4}, isEven)
+	require.ContainsElementFunc(fake(t, "** got [1 3 5], wanted an element matching the predicate"), []int{1, 3, 5}, isEven)
+}
+
+func TestNotContainsElementFunc(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	require.NotContainsElementFunc(noerr(t), []int{1, 3, 5}, isEven)
+	require.NotContainsElementFunc(fake(t, "** got [1 3 4], wanted no element matching the predicate"), []int{1, 3, 4}, isEven)
+}
+
+func TestContainsKey(t *testing.T) {
+	require.ContainsKey(noerr(t), map[string]int{"a": 1}, "a")
+	require.ContainsKey(fake(t, "** got map[a:1], wanted to contain key b"), map[string]int{"a": 1}, "b")
+}
+
+func TestNotContainsKey(t *testing.T) {
+	require.NotContainsKey(noerr(t), map[string]int{"a": 1}, "b")
+	require.NotContainsKey(fake(t, "** got map[a:1], wanted to not contain key a"), map[string]int{"a": 1}, "a")
+}
+
+func TestEventually(t *testing.T) {
+	n := 0
+	require.Eventually(noerr(t), func() bool { n++; return n >= 3 }, time.Second, time.Millisecond)
+	require.Eventually(fake(t, "** timed out after 10ms waiting for condition to become true"), func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestNever(t *testing.T) {
+	require.Never(noerr(t), func() bool { return false }, 10*time.Millisecond, time.Millisecond)
+	require.Never(fake(t, "** got condition become true, wanted it to stay false for 10ms"), func() bool { return true }, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestEventuallyEq(t *testing.T) {
+	n := 0
+	require.EventuallyEq(noerr(t), func() int { n++; return n }, 3, time.Second, time.Millisecond)
+	require.EventuallyEq(fake(t, "** timed out after 10ms; last got 3, wanted 5"), func() int { return 3 }, 5, 10*time.Millisecond, time.Millisecond)
+}
+
+func TestZero(t *testing.T) {
+	require.Zero(noerr(t), 0)
+	require.Zero(fake(t, "** got 42, wanted zero value 0"), 42)
+}
+
+func TestNonZero(t *testing.T) {
+	require.NonZero(noerr(t), 42)
+	require.NonZero(fake(t, "** got zero value 0, wanted non-zero"), 0)
+}
+
+func TestEmptySlice(t *testing.T) {
+	require.EmptySlice(noerr(t), []int(nil))
+	require.EmptySlice(fake(t, "** got [42], wanted empty slice"), []int{42})
+}
+
+func TestNonEmptySlice(t *testing.T) {
+	require.NonEmptySlice(noerr(t), []int{42})
+	require.NonEmptySlice(fake(t, "** got empty []int, wanted non-empty"), []int{})
+}
+
+func TestEmptyMap(t *testing.T) {
+	require.EmptyMap(noerr(t), map[int]string(nil))
+	require.EmptyMap(fake(t, "** got map[42:x], wanted empty map"), map[int]string{42: "x"})
+}
+
+func TestNonEmptyMap(t *testing.T) {
+	require.NonEmptyMap(noerr(t), map[int]string{42: "x"})
+	require.NonEmptyMap(fake(t, "** got empty map[int]string, wanted non-empty"), map[int]string{})
+}
+
+func TestSuccess(t *testing.T) {
+	require.Success(noerr(t), error(nil))
+	require.Success(fake(t, "** failed: file does not exist"), fs.ErrNotExist)
+}
+
+func TestError_ok(t *testing.T) {
+	require.Error(noerr(t), fs.ErrNotExist, fs.ErrNotExist)
+}
+func TestError_assert_success(t *testing.T) {
+	require.Error(noerr(t), error(nil), nil)
+	require.Error(fake(t, "** failed: file does not exist"), fs.ErrNotExist, nil)
+}
+func TestError_wrong_error(t *testing.T) {
+	require.Error(fake(t, "** failed with: file already exists, wanted: file does not exist"), fs.ErrExist, fs.ErrNotExist)
+}
+func TestError_unexpected_success(t *testing.T) {
+	require.Error(fake(t, "** succeeded, wanted to fail with: file does not exist"), nil, fs.ErrNotExist)
+}
+
+func TestErrorMsg_ok(t *testing.T) {
+	require.ErrorMsg(noerr(t), fs.ErrNotExist, "file does not exist")
+}
+func TestErrorMsg_assert_success(t *testing.T) {
+	require.ErrorMsg(noerr(t), error(nil), "")
+	require.ErrorMsg(fake(t, "** failed: file does not exist"), fs.ErrNotExist, "")
+}
+func TestErrorMsg_wrong_error(t *testing.T) {
+	require.ErrorMsg(fake(t, "** failed with: file already exists, wanted: file does not exist"), fs.ErrExist, "file does not exist")
+}
+func TestErrorMsg_unexpected_success(t *testing.T) {
+	require.ErrorMsg(fake(t, "** succeeded, wanted to fail with: file does not exist"), nil, "file does not exist")
+}
+
+func TestPanicMsg_ok(t *testing.T) {
+	require.PanicMsg(noerr(t), panickyFunc, "runtime error: index out of range [2] with length 2")
+}
+func TestPanicMsg_unexpected_success(t *testing.T) {
+	require.PanicMsg(fake(t, "** succeeded, wanted to panic with: foo"), func() {}, "foo")
+}
+func TestPanicMsg_wrong_error(t *testing.T) {
+	require.PanicMsg(fake(t, "** paniced with: runtime error: index out of range [2] with length 2, wanted: foo"), panickyFunc, "foo")
+}
+
+func panickyFunc() {
+	a := make([]int, 2)
+	a[2] = 42
+}
+
+type noErrTB struct {
+	t testing.TB
+}
+
+func noerr(t testing.TB) require.TB {
+	return &noErrTB{t}
+}
+func (f *noErrTB) Helper() {
+}
+func (f *noErrTB) Errorf(format string, args ...any) {
+	f.t.Helper()
+	f.t.Fatalf("unexpected error: %s", fmt.Sprintf(format, args...))
+}
+func (f *noErrTB) FailNow() {
+	f.t.Helper()
+	f.t.Fatal("unexpected FailNow")
+}
+
+type fakeTB struct {
+	t             testing.TB
+	helperCalled  bool
+	errorCalled   bool
+	failNowCalled bool
+	expected      string
+}
+
+func fake(t testing.TB, expected string) require.TB {
+	f := &fakeTB{
+		t:        t,
+		expected: expected,
+	}
+	t.Cleanup(f.verify)
+	return f
+}
+
+func (f *fakeTB) verify() {
+	f.t.Helper()
+	if !f.errorCalled {
+		f.t.Fatal("Errorf not called")
+	}
+	if !f.helperCalled {
+		f.t.Fatal("Helper not called")
+	}
+	if !f.failNowCalled {
+		f.t.Fatal("FailNow not called")
+	}
+}
+
+func (f *fakeTB) Helper() {
+	f.t.Helper()
+	f.helperCalled = true
+}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.t.Helper()
+	actual := fmt.Sprintf(format, args...)
+	if actual != f.expected {
+		f.t.Fatalf("incorrect error message, got:\n\t%s\nwanted:\n\t%s", actual, f.expected)
+	}
+	f.errorCalled = true
+}
+
+func (f *fakeTB) FailNow() {
+	f.failNowCalled = true
+}